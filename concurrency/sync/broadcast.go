@@ -0,0 +1,51 @@
+package sync
+
+import (
+	"context"
+	"sync"
+)
+
+// Broadcast is a rearmable, one-to-many signal. Any number of goroutines can Wait() for the next
+// Signal(), and every goroutine waiting at the time of the Signal() is released. After a Signal(),
+// the Broadcast is automatically rearmed for the next round of waiters.
+//
+// This is intended to replace uses of sync.Cond for notifying watchers that some state has changed
+// (config reloaded, leader elected, ...). Unlike sync.Cond, Wait() honors context cancellation.
+//
+// A Broadcast does not carry state. A waiter that calls Wait() after a Signal() has happened will
+// block until the next Signal(). Callers should check the state they care about after Wait() returns.
+//
+// The zero value is ready to use. A Broadcast must not be copied after first use.
+type Broadcast struct {
+	mu sync.Mutex
+	ch chan struct{}
+}
+
+// Wait blocks until the next call to Signal() or until the ctx is done. If the ctx is done first,
+// this returns ctx.Err().
+func (b *Broadcast) Wait(ctx context.Context) error {
+	b.mu.Lock()
+	if b.ch == nil {
+		b.ch = make(chan struct{})
+	}
+	ch := b.ch
+	b.mu.Unlock()
+
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Signal wakes all goroutines currently blocked in Wait(). If there are no waiters, this is a no-op.
+func (b *Broadcast) Signal() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.ch != nil {
+		close(b.ch)
+		b.ch = nil
+	}
+}
@@ -0,0 +1,100 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/synctest"
+)
+
+func TestBroadcastNoMissedWakeups(t *testing.T) {
+	t.Parallel()
+
+	const (
+		rounds  = 100
+		waiters = 50
+	)
+
+	synctest.Test(t, func(t *testing.T) {
+		b := &Broadcast{}
+
+		for round := 0; round < rounds; round++ {
+			var (
+				wg   sync.WaitGroup
+				errs atomic.Int32
+			)
+			for i := 0; i < waiters; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if err := b.Wait(context.Background()); err != nil {
+						errs.Add(1)
+					}
+				}()
+			}
+
+			// Wait until every waiter is parked in Wait() before signaling.
+			synctest.Wait()
+			b.Signal()
+			wg.Wait()
+
+			if n := errs.Load(); n != 0 {
+				t.Fatalf("TestBroadcastNoMissedWakeups(round %d): got %d waiters returning an error, want 0", round, n)
+			}
+		}
+	})
+}
+
+func TestBroadcastWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	b := &Broadcast{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := b.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("TestBroadcastWaitCanceled: got err == %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestBroadcastSignalNoWaiters(t *testing.T) {
+	t.Parallel()
+
+	b := &Broadcast{}
+	b.Signal()
+	b.Signal()
+
+	if b.ch != nil {
+		t.Errorf("TestBroadcastSignalNoWaiters: got non-nil channel after Signal() with no waiters")
+	}
+}
+
+func TestBroadcastWaitAfterSignal(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		b := &Broadcast{}
+		b.Signal()
+
+		var returned atomic.Bool
+		go func() {
+			if err := b.Wait(context.Background()); err != nil {
+				t.Errorf("TestBroadcastWaitAfterSignal: got err == %v, want nil", err)
+			}
+			returned.Store(true)
+		}()
+
+		synctest.Wait()
+		if returned.Load() {
+			t.Fatalf("TestBroadcastWaitAfterSignal: Wait() returned before the next Signal()")
+		}
+
+		b.Signal()
+		synctest.Wait()
+		if !returned.Load() {
+			t.Fatalf("TestBroadcastWaitAfterSignal: Wait() did not return after Signal()")
+		}
+	})
+}
@@ -0,0 +1,2 @@
+// Package sync provides safer, context-aware alternatives to primitives in the standard library's sync package.
+package sync
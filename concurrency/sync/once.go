@@ -0,0 +1,82 @@
+package sync
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// OnceErr is like the standard library's sync.Once, except the function can return an error
+// and the OnceErr can be Reset(). This is useful for lazy, fallible initialization such as
+// opening a connection, where a failed attempt should be retryable.
+//
+// If the function passed to Do() panics, the result is not recorded and the next call to Do()
+// will run its function again.
+//
+// The zero value is ready to use. An OnceErr must not be copied after first use.
+type OnceErr struct {
+	mu     sync.Mutex
+	result atomic.Pointer[onceResult[struct{}]]
+}
+
+type onceResult[T any] struct {
+	v   T
+	err error
+}
+
+// Do calls f if and only if Do has not been called since the OnceErr was created
+// or last Reset(). The error returned by f is cached and returned by all subsequent calls to Do
+// without calling their f. Concurrent callers block until the first call to f returns.
+func (o *OnceErr) Do(f func() error) error {
+	if r := o.result.Load(); r != nil {
+		return r.err
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if r := o.result.Load(); r != nil {
+		return r.err
+	}
+	err := f()
+	o.result.Store(&onceResult[struct{}]{err: err})
+	return err
+}
+
+// Reset clears the cached result so that the next call to Do runs its function.
+// This is usually called after Do returned an error so that initialization can be retried.
+func (o *OnceErr) Reset() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.result.Store(nil)
+}
+
+// OnceValue is like OnceErr, but caches the value returned by the function along with the error.
+//
+// If the function passed to Do() panics, the result is not recorded and the next call to Do()
+// will run its function again.
+//
+// The zero value is ready to use. An OnceValue must not be copied after first use.
+type OnceValue[T any] struct {
+	mu     sync.Mutex
+	result atomic.Pointer[onceResult[T]]
+}
+
+// Do calls f if and only if Do has not been called before. The value and error returned by f are
+// cached and returned by all subsequent calls to Do without calling their f. Concurrent callers block
+// until the first call to f returns.
+func (o *OnceValue[T]) Do(f func() (T, error)) (T, error) {
+	if r := o.result.Load(); r != nil {
+		return r.v, r.err
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if r := o.result.Load(); r != nil {
+		return r.v, r.err
+	}
+	v, err := f()
+	o.result.Store(&onceResult[T]{v: v, err: err})
+	return v, err
+}
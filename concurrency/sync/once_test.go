@@ -0,0 +1,138 @@
+package sync
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestOnceErrConcurrentDo(t *testing.T) {
+	t.Parallel()
+
+	const callers = 100
+
+	var (
+		o       OnceErr
+		calls   atomic.Int32
+		wg      sync.WaitGroup
+		start   = make(chan struct{})
+		wantErr = errors.New("init failed")
+		got     = make([]error, callers)
+	)
+
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			got[i] = o.Do(func() error {
+				calls.Add(1)
+				return wantErr
+			})
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("TestOnceErrConcurrentDo: got %d calls to f, want 1", n)
+	}
+	for i, err := range got {
+		if err != wantErr {
+			t.Errorf("TestOnceErrConcurrentDo(caller %d): got err == %v, want %v", i, err, wantErr)
+		}
+	}
+}
+
+func TestOnceErrReset(t *testing.T) {
+	t.Parallel()
+
+	var o OnceErr
+	wantErr := errors.New("init failed")
+
+	if err := o.Do(func() error { return wantErr }); err != wantErr {
+		t.Fatalf("TestOnceErrReset: got err == %v, want %v", err, wantErr)
+	}
+	// Without a Reset(), the cached error is returned and f is not called.
+	if err := o.Do(func() error { t.Error("TestOnceErrReset: f called before Reset()"); return nil }); err != wantErr {
+		t.Fatalf("TestOnceErrReset: got err == %v, want %v", err, wantErr)
+	}
+
+	o.Reset()
+
+	ran := false
+	if err := o.Do(func() error { ran = true; return nil }); err != nil {
+		t.Fatalf("TestOnceErrReset: after Reset(): got err == %v, want nil", err)
+	}
+	if !ran {
+		t.Errorf("TestOnceErrReset: after Reset(): f was not called")
+	}
+}
+
+func TestOnceErrPanic(t *testing.T) {
+	t.Parallel()
+
+	var o OnceErr
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("TestOnceErrPanic: expected panic to propagate from Do()")
+			}
+		}()
+		o.Do(func() error { panic("boom") })
+	}()
+
+	ran := false
+	if err := o.Do(func() error { ran = true; return nil }); err != nil {
+		t.Fatalf("TestOnceErrPanic: got err == %v, want nil", err)
+	}
+	if !ran {
+		t.Errorf("TestOnceErrPanic: f was not called again after a panic")
+	}
+}
+
+func TestOnceValueConcurrentDo(t *testing.T) {
+	t.Parallel()
+
+	const (
+		callers = 100
+		want    = 42
+	)
+
+	var (
+		o     OnceValue[int]
+		calls atomic.Int32
+		wg    sync.WaitGroup
+		start = make(chan struct{})
+		got   = make([]int, callers)
+		errs  = make([]error, callers)
+	)
+
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			got[i], errs[i] = o.Do(func() (int, error) {
+				calls.Add(1)
+				return want, nil
+			})
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("TestOnceValueConcurrentDo: got %d calls to f, want 1", n)
+	}
+	for i := range got {
+		if errs[i] != nil {
+			t.Errorf("TestOnceValueConcurrentDo(caller %d): got err == %v, want nil", i, errs[i])
+		}
+		if got[i] != want {
+			t.Errorf("TestOnceValueConcurrentDo(caller %d): got %d, want %d", i, got[i], want)
+		}
+	}
+}
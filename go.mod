@@ -0,0 +1,3 @@
+module github.com/gostdlib/base
+
+go 1.25